@@ -0,0 +1,58 @@
+// Command ghz-serve starts ghz as a long-running controller: a gRPC service
+// (plus its REST/JSON gateway) that accepts StartRun calls and streams run
+// progress, so ghz can act as a worker node inside a distributed load-test
+// harness.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	"github.com/bojand/ghz/controller"
+	pb "github.com/bojand/ghz/controller/proto"
+)
+
+func main() {
+	grpcAddr := flag.String("grpc-addr", ":50555", "address for the gRPC controller service")
+	httpAddr := flag.String("http-addr", ":8080", "address for the REST/JSON gateway")
+	flag.Parse()
+
+	srv := controller.NewServer(controller.NewMemoryStore())
+
+	lis, err := net.Listen("tcp", *grpcAddr)
+	if err != nil {
+		log.Fatalf("ghz-serve: failed to listen on %s: %v", *grpcAddr, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterControllerServer(grpcServer, srv)
+
+	go func() {
+		log.Printf("ghz-serve: gRPC controller listening on %s", *grpcAddr)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("ghz-serve: gRPC server error: %v", err)
+		}
+	}()
+
+	ctx := context.Background()
+	mux := runtime.NewServeMux()
+	// The gateway dials the gRPC service over loopback, so plaintext
+	// transport is fine regardless of how external clients reach it.
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := pb.RegisterControllerHandlerFromEndpoint(ctx, mux, *grpcAddr, dialOpts); err != nil {
+		log.Fatalf("ghz-serve: failed to register REST gateway: %v", err)
+	}
+
+	log.Printf("ghz-serve: REST gateway listening on %s", *httpAddr)
+	if err := http.ListenAndServe(*httpAddr, mux); err != nil {
+		log.Fatalf("ghz-serve: HTTP server error: %v", err)
+	}
+}
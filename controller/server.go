@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bojand/ghz/runner"
+
+	pb "github.com/bojand/ghz/controller/proto"
+)
+
+// tickInterval is how often an in-progress run reports a progress Tick to
+// its StartRun stream.
+const tickInterval = 500 * time.Millisecond
+
+// Server implements the Controller gRPC service, wrapping the runner
+// package so a ghz run can be started, watched, and cancelled remotely.
+type Server struct {
+	pb.UnimplementedControllerServer
+
+	store Store
+}
+
+// NewServer returns a Server backed by store. Pass NewMemoryStore() for a
+// single-node deployment, or a custom Store to persist runs elsewhere.
+func NewServer(store Store) *Server {
+	return &Server{store: store}
+}
+
+// StartRun runs a load test described by req and streams its progress,
+// finishing with a terminal Report event, or with an error event if the
+// run fails or is cancelled.
+func (s *Server) StartRun(req *pb.RunRequest, stream pb.Controller_StartRunServer) error {
+	newID, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	id := newID.String()
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	run := NewRun(id, cancel)
+	if err := s.store.Put(run); err != nil {
+		return err
+	}
+
+	opts, err := runOptions(req)
+	if err != nil {
+		run.SetError(err)
+		_ = s.store.Put(run)
+		return err
+	}
+	// Bind ctx to the run itself (not just this stream) so that cancel,
+	// called from Cancel below, stops the in-flight workers instead of
+	// only severing the caller's view of the run.
+	opts = append(opts, runner.WithContext(ctx))
+
+	// snapshots carries the most recent progress Snapshot pushed by the
+	// run itself; it's 1-buffered and drained-then-refilled on send so a
+	// slow consumer always sees the latest snapshot, never a stale queue.
+	snapshots := make(chan runner.Snapshot, 1)
+	opts = append(opts, runner.WithProgressListener(func(snap runner.Snapshot) {
+		select {
+		case snapshots <- snap:
+		default:
+			select {
+			case <-snapshots:
+			default:
+			}
+			snapshots <- snap
+		}
+	}))
+
+	reportCh := make(chan *runner.Report, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		report, err := runner.Run(req.GetCall(), req.GetHost(), opts...)
+		if err != nil {
+			errCh <- err
+			return
+		}
+		reportCh <- report
+	}()
+
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	var last runner.Snapshot
+	for {
+		select {
+		case <-ctx.Done():
+			run.SetCancelled()
+			_ = s.store.Put(run)
+			return stream.Send(&pb.RunEvent{Id: id, Payload: &pb.RunEvent_Error{Error: "run cancelled"}})
+
+		case err := <-errCh:
+			run.SetError(err)
+			_ = s.store.Put(run)
+			return stream.Send(&pb.RunEvent{Id: id, Payload: &pb.RunEvent_Error{Error: err.Error()}})
+
+		case report := <-reportCh:
+			run.SetDone(report)
+			_ = s.store.Put(run)
+			return stream.Send(&pb.RunEvent{Id: id, Payload: &pb.RunEvent_Report{Report: toPBReport(id, report)}})
+
+		case snap := <-snapshots:
+			last = snap
+
+		case <-ticker.C:
+			if err := stream.Send(&pb.RunEvent{Id: id, Payload: &pb.RunEvent_Tick{Tick: toPBTick(last)}}); err != nil {
+				cancel()
+				return err
+			}
+		}
+	}
+}
+
+// Cancel stops the run identified by id, if it is still in progress.
+func (s *Server) Cancel(ctx context.Context, id *pb.RunID) (*pb.CancelResponse, error) {
+	run, err := s.store.Get(id.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if run.Cancel != nil {
+		run.Cancel()
+	}
+	return &pb.CancelResponse{Ok: true}, nil
+}
+
+// GetReport returns the terminal report for a completed run.
+func (s *Server) GetReport(ctx context.Context, id *pb.RunID) (*pb.Report, error) {
+	run, err := s.store.Get(id.GetId())
+	if err != nil {
+		return nil, err
+	}
+	report, ok := run.Report().(*runner.Report)
+	if !ok {
+		return nil, ErrRunNotFound
+	}
+	return toPBReport(run.ID, report), nil
+}
+
+// ListRuns returns the IDs and status of every known run.
+func (s *Server) ListRuns(ctx context.Context, _ *pb.ListRunsRequest) (*pb.ListRunsResponse, error) {
+	runs, err := s.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListRunsResponse{Runs: make([]*pb.RunSummary, 0, len(runs))}
+	for _, run := range runs {
+		resp.Runs = append(resp.Runs, &pb.RunSummary{Id: run.ID, Status: toPBStatus(run.Status())})
+	}
+	return resp, nil
+}
@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrRunNotFound is returned by a Store when no run matches the given ID.
+var ErrRunNotFound = errors.New("controller: run not found")
+
+// RunStatus is the lifecycle state of a tracked run.
+type RunStatus int
+
+// Run statuses.
+const (
+	StatusUnknown RunStatus = iota
+	StatusRunning
+	StatusDone
+	StatusCancelled
+	StatusError
+)
+
+// Run is the controller's bookkeeping record for a single StartRun call.
+// Its fields are mutated from the StartRun goroutine while Cancel,
+// GetReport, and ListRuns read them concurrently from other goroutines via
+// the Store, so all access goes through the Run's own lock rather than the
+// Store's (a Store only needs to guard its own index, not the records it
+// hands out).
+type Run struct {
+	ID string
+
+	// Cancel stops the in-flight run, if any; it may be nil. It stays set
+	// after the run has finished, when calling it has no effect. Safe for
+	// concurrent use.
+	Cancel func()
+
+	mu     sync.Mutex
+	status RunStatus
+	report interface{}
+	err    error
+}
+
+// NewRun returns a Run in StatusRunning for id, cancelled via cancel.
+func NewRun(id string, cancel func()) *Run {
+	return &Run{ID: id, Cancel: cancel, status: StatusRunning}
+}
+
+// Status returns the run's current lifecycle state.
+func (r *Run) Status() RunStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status
+}
+
+// Report returns the terminal report set by SetDone, or nil if the run
+// hasn't finished successfully.
+func (r *Run) Report() interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.report
+}
+
+// Err returns the failure reason set by SetError, or nil.
+func (r *Run) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// SetDone marks the run StatusDone with its terminal report.
+func (r *Run) SetDone(report interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = StatusDone
+	r.report = report
+}
+
+// SetError marks the run StatusError with the failure reason.
+func (r *Run) SetError(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = StatusError
+	r.err = err
+}
+
+// SetCancelled marks the run StatusCancelled.
+func (r *Run) SetCancelled() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = StatusCancelled
+}
+
+// Store persists Run records across the lifetime of a controller process.
+// The default in-memory implementation is sufficient for a single node;
+// a pluggable Store lets callers back this with Redis, a DB, etc. so
+// GetReport/ListRuns survive a controller restart.
+type Store interface {
+	// Put creates or replaces the run record for run.ID.
+	Put(run *Run) error
+	// Get returns the run record for id, or ErrRunNotFound.
+	Get(id string) (*Run, error)
+	// List returns all known run records.
+	List() ([]*Run, error)
+	// Delete removes the run record for id, if present.
+	Delete(id string) error
+}
+
+// memoryStore is the default in-memory Store, safe for concurrent use.
+type memoryStore struct {
+	mu   sync.RWMutex
+	runs map[string]*Run
+}
+
+// NewMemoryStore returns a Store that keeps all run records in memory for
+// the lifetime of the process.
+func NewMemoryStore() Store {
+	return &memoryStore{runs: make(map[string]*Run)}
+}
+
+func (s *memoryStore) Put(run *Run) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs[run.ID] = run
+	return nil
+}
+
+func (s *memoryStore) Get(id string) (*Run, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	run, ok := s.runs[id]
+	if !ok {
+		return nil, ErrRunNotFound
+	}
+	return run, nil
+}
+
+func (s *memoryStore) List() ([]*Run, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	runs := make([]*Run, 0, len(s.runs))
+	for _, run := range s.runs {
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+func (s *memoryStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.runs, id)
+	return nil
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	s := NewMemoryStore()
+
+	_, err := s.Get("missing")
+	assert.Equal(t, ErrRunNotFound, err)
+
+	run := NewRun("run-1", nil)
+	require.NoError(t, s.Put(run))
+
+	got, err := s.Get("run-1")
+	require.NoError(t, err)
+	assert.Same(t, run, got)
+	assert.Equal(t, StatusRunning, got.Status())
+
+	runs, err := s.List()
+	require.NoError(t, err)
+	assert.Len(t, runs, 1)
+
+	require.NoError(t, s.Delete("run-1"))
+	_, err = s.Get("run-1")
+	assert.Equal(t, ErrRunNotFound, err)
+}
+
+func TestRunLifecycle(t *testing.T) {
+	run := NewRun("run-1", nil)
+	assert.Equal(t, StatusRunning, run.Status())
+	assert.Nil(t, run.Report())
+	assert.NoError(t, run.Err())
+
+	run.SetDone("a report")
+	assert.Equal(t, StatusDone, run.Status())
+	assert.Equal(t, "a report", run.Report())
+
+	run.SetError(ErrRunNotFound)
+	assert.Equal(t, StatusError, run.Status())
+	assert.Equal(t, ErrRunNotFound, run.Err())
+
+	run.SetCancelled()
+	assert.Equal(t, StatusCancelled, run.Status())
+}
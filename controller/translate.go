@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/bojand/ghz/runner"
+
+	pb "github.com/bojand/ghz/controller/proto"
+)
+
+// runOptions translates a RunRequest into the runner.RunOption values that
+// configure a run.
+func runOptions(req *pb.RunRequest) ([]runner.RunOption, error) {
+	var opts []runner.RunOption
+
+	switch {
+	case req.GetProto() != "":
+		opts = append(opts, runner.WithProtoFile(req.GetProto(), req.GetImportPaths()))
+	case req.GetProtoset() != "":
+		opts = append(opts, runner.WithProtoset(req.GetProtoset()))
+	case req.GetBinary() != "":
+		opts = append(opts, runner.WithReflectionService())
+	}
+
+	if req.GetData() != "" {
+		opts = append(opts, runner.WithDataFromJSON(req.GetData()))
+	}
+	if req.GetDataPath() != "" {
+		opts = append(opts, runner.WithDataFromFile(req.GetDataPath()))
+	}
+	if req.GetMetadata() != "" {
+		opts = append(opts, runner.WithMetadataFromJSON(req.GetMetadata()))
+	}
+	if req.GetMetadataPath() != "" {
+		opts = append(opts, runner.WithMetadataFromFile(req.GetMetadataPath()))
+	}
+
+	if req.GetConcurrency() > 0 {
+		opts = append(opts, runner.WithConcurrency(uint(req.GetConcurrency())))
+	}
+	if req.GetRps() > 0 {
+		opts = append(opts, runner.WithRPS(uint(req.GetRps())))
+	}
+	if req.GetTotal() > 0 {
+		opts = append(opts, runner.WithTotalRequests(uint(req.GetTotal())))
+	}
+	if req.GetDuration() != "" {
+		d, err := time.ParseDuration(req.GetDuration())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, runner.WithRunDuration(d))
+	}
+
+	if req.GetInsecure() {
+		opts = append(opts, runner.WithInsecure(true))
+	}
+
+	return opts, nil
+}
+
+func toPBReport(id string, report *runner.Report) *pb.Report {
+	if report == nil {
+		return &pb.Report{Id: id}
+	}
+	return &pb.Report{
+		Id:               id,
+		Count:            uint64(report.Count),
+		TotalSeconds:     report.Total.Seconds(),
+		AverageLatencyMs: float64(report.Average.Milliseconds()),
+		Rps:              report.Rps,
+		StatusCodes:      report.StatusCodes,
+	}
+}
+
+// toPBTick translates a runner.Snapshot into the wire Tick sent on every
+// StartRun tickInterval. The zero Snapshot (before the run's first
+// progress update arrives) maps to an all-zero Tick.
+func toPBTick(snap runner.Snapshot) *pb.Tick {
+	return &pb.Tick{
+		Completed:    snap.Completed,
+		Errors:       snap.Errors,
+		CurrentRps:   snap.CurrentRPS,
+		P50LatencyMs: snap.P50LatencyMs,
+		P99LatencyMs: snap.P99LatencyMs,
+	}
+}
+
+func toPBStatus(s RunStatus) pb.RunStatus {
+	switch s {
+	case StatusRunning:
+		return pb.RunStatus_RUN_STATUS_RUNNING
+	case StatusDone:
+		return pb.RunStatus_RUN_STATUS_DONE
+	case StatusCancelled:
+		return pb.RunStatus_RUN_STATUS_CANCELLED
+	case StatusError:
+		return pb.RunStatus_RUN_STATUS_ERROR
+	default:
+		return pb.RunStatus_RUN_STATUS_UNKNOWN
+	}
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bojand/ghz/runner"
+
+	pb "github.com/bojand/ghz/controller/proto"
+)
+
+func TestRunOptions(t *testing.T) {
+	t.Run("invalid duration is rejected", func(t *testing.T) {
+		_, err := runOptions(&pb.RunRequest{Duration: "not-a-duration"})
+		assert.Error(t, err)
+	})
+
+	t.Run("one option per populated field", func(t *testing.T) {
+		opts, err := runOptions(&pb.RunRequest{
+			Proto:       "greeter.proto",
+			Concurrency: 10,
+			Rps:         50,
+			Total:       1000,
+			Duration:    "5s",
+			Insecure:    true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, opts, 6)
+	})
+
+	t.Run("proto/protoset/binary are mutually exclusive", func(t *testing.T) {
+		opts, err := runOptions(&pb.RunRequest{Proto: "greeter.proto", Protoset: "greeter.protoset"})
+		assert.NoError(t, err)
+		assert.Len(t, opts, 1)
+	})
+}
+
+func TestToPBReport(t *testing.T) {
+	t.Run("nil report", func(t *testing.T) {
+		got := toPBReport("run-1", nil)
+		assert.Equal(t, &pb.Report{Id: "run-1"}, got)
+	})
+
+	t.Run("populated report", func(t *testing.T) {
+		report := &runner.Report{
+			Count:       100,
+			Total:       2 * time.Second,
+			Average:     20 * time.Millisecond,
+			Rps:         50,
+			StatusCodes: map[string]uint64{"OK": 98, "Unavailable": 2},
+		}
+		got := toPBReport("run-1", report)
+		assert.Equal(t, "run-1", got.Id)
+		assert.Equal(t, uint64(100), got.Count)
+		assert.Equal(t, 2.0, got.TotalSeconds)
+		assert.Equal(t, 20.0, got.AverageLatencyMs)
+		assert.Equal(t, 50.0, got.Rps)
+		assert.Equal(t, map[string]uint64{"OK": 98, "Unavailable": 2}, got.StatusCodes)
+	})
+}
+
+func TestToPBTick(t *testing.T) {
+	got := toPBTick(runner.Snapshot{
+		Completed:    10,
+		Errors:       1,
+		CurrentRPS:   25.5,
+		P50LatencyMs: 12.3,
+		P99LatencyMs: 45.6,
+	})
+	assert.Equal(t, &pb.Tick{
+		Completed:    10,
+		Errors:       1,
+		CurrentRps:   25.5,
+		P50LatencyMs: 12.3,
+		P99LatencyMs: 45.6,
+	}, got)
+}
+
+func TestToPBStatus(t *testing.T) {
+	cases := map[RunStatus]pb.RunStatus{
+		StatusRunning:   pb.RunStatus_RUN_STATUS_RUNNING,
+		StatusDone:      pb.RunStatus_RUN_STATUS_DONE,
+		StatusCancelled: pb.RunStatus_RUN_STATUS_CANCELLED,
+		StatusError:     pb.RunStatus_RUN_STATUS_ERROR,
+		StatusUnknown:   pb.RunStatus_RUN_STATUS_UNKNOWN,
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, toPBStatus(in))
+	}
+}
@@ -2,9 +2,16 @@ package runner
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"math/rand"
 	"path"
@@ -13,6 +20,7 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/cespare/xxhash/v2"
 	"github.com/google/uuid"
 	"github.com/jhump/protoreflect/desc"
 )
@@ -33,13 +41,21 @@ type callTemplateData struct {
 	TimestampUnixMilli int64  // timestamp of the call as unix time in milliseconds
 	TimestampUnixNano  int64  // timestamp of the call as unix time in nanoseconds
 	UUID               string // generated UUIDv4 for each call
+
+	dataSources map[string]DataSource // registered external data sources, keyed by name
+	workerCount int                   // total number of concurrent workers, for Partition
 }
 
 // newCallTemplateData returns new call template data
-func newCallTemplateData(mtd *desc.MethodDescriptor, workerID string, reqNum int64) *callTemplateData {
+func newCallTemplateData(mtd *desc.MethodDescriptor, workerID string, reqNum int64, opts ...Option) *callTemplateData {
 	now := time.Now()
 	newUUID, _ := uuid.NewRandom()
 
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	return &callTemplateData{
 		WorkerID:           workerID,
 		RequestNumber:      reqNum,
@@ -55,7 +71,37 @@ func newCallTemplateData(mtd *desc.MethodDescriptor, workerID string, reqNum int
 		TimestampUnixMilli: now.UnixNano() / 1000000,
 		TimestampUnixNano:  now.UnixNano(),
 		UUID:               newUUID.String(),
+		dataSources:        o.dataSources,
+		workerCount:        o.workerCount,
+	}
+}
+
+// weightedChoice returns values[i] with probability weights[i]/sum(weights).
+func weightedChoice(values []string, weights []int) (string, error) {
+	if len(values) < 1 {
+		return "", errors.New("values is empty")
+	}
+	if len(values) != len(weights) {
+		return "", errors.New("values and weights must be the same length")
 	}
+	sum := 0
+	for _, w := range weights {
+		if w < 0 {
+			return "", errors.New("weights must be non-negative")
+		}
+		sum += w
+	}
+	if sum == 0 {
+		return "", errors.New("weights must not sum to zero")
+	}
+	r := rand.Intn(sum)
+	for i, w := range weights {
+		r -= w
+		if r < 0 {
+			return values[i], nil
+		}
+	}
+	return values[len(values)-1], nil
 }
 
 func (td *callTemplateData) execute(data string) (*bytes.Buffer, error) {
@@ -174,6 +220,29 @@ func (td *callTemplateData) execute(data string) (*bytes.Buffer, error) {
 			rand.Shuffle(len(values), func(i, j int) { values[i], values[j] = values[j], values[i] })
 			return values
 		},
+		// Randomly choose one value from values, where values[i] is chosen with probability weights[i]/sum(weights)
+		"WeightedChoice": weightedChoice,
+		// Returns a normally distributed int with the given mean and standard deviation
+		"NormalInt": func(mean, stddev float64) string {
+			value := rand.NormFloat64()*stddev + mean
+			return strconv.FormatInt(int64(value), 10)
+		},
+		// Returns an exponentially distributed int, useful for think-time/inter-arrival simulation, with rate lambdaMs (mean 1/lambdaMs)
+		"ExpInt": func(lambdaMs float64) string {
+			value := rand.ExpFloat64() / lambdaMs
+			return strconv.FormatInt(int64(value), 10)
+		},
+		// Returns a Zipf-distributed uint64 in [0, imax], skewed by s (>1) and v (>=1); useful for hot-key simulation
+		"ZipfInt": func(s, v float64, imax uint64) (string, error) {
+			// rand.NewZipf needs its own *rand.Rand, which isn't safe for concurrent
+			// use; seed a fresh one per call from the (concurrency-safe) global source.
+			r := rand.New(rand.NewSource(rand.Int63()))
+			z := rand.NewZipf(r, s, v, imax)
+			if z == nil {
+				return "", errors.New("invalid zipf parameters: s must be > 1 and v >= 1")
+			}
+			return strconv.FormatUint(z.Uint64(), 10), nil
+		},
 		// RoundRobin-ly select one value from values, mod with RequestNumber
 		"RoundRobin": func(values []string) (string, error) {
 			if len(values) < 1 {
@@ -182,6 +251,123 @@ func (td *callTemplateData) execute(data string) (*bytes.Buffer, error) {
 			value := values[td.RequestNumber%int64(len(values))]
 			return value, nil
 		},
+		// Generate a time-ordered UUIDv1
+		"UUIDv1": func() (string, error) {
+			id, err := uuid.NewUUID()
+			if err != nil {
+				return "", err
+			}
+			return id.String(), nil
+		},
+		// Generate a deterministic name-based UUIDv3 (MD5) from a namespace UUID and a name
+		"UUIDv3": func(namespace, name string) (string, error) {
+			ns, err := uuid.Parse(namespace)
+			if err != nil {
+				return "", err
+			}
+			return uuid.NewMD5(ns, []byte(name)).String(), nil
+		},
+		// Generate a deterministic name-based UUIDv5 (SHA-1) from a namespace UUID and a name
+		"UUIDv5": func(namespace, name string) (string, error) {
+			ns, err := uuid.Parse(namespace)
+			if err != nil {
+				return "", err
+			}
+			return uuid.NewSHA1(ns, []byte(name)).String(), nil
+		},
+		// Generate a time-ordered, Unix Epoch-based UUIDv7
+		"UUIDv7": func() (string, error) {
+			id, err := uuid.NewV7()
+			if err != nil {
+				return "", err
+			}
+			return id.String(), nil
+		},
+		// MD5 hex digest of the input
+		"MD5": func(data string) string {
+			sum := md5.Sum([]byte(data))
+			return hex.EncodeToString(sum[:])
+		},
+		// SHA1 hex digest of the input
+		"SHA1": func(data string) string {
+			sum := sha1.Sum([]byte(data))
+			return hex.EncodeToString(sum[:])
+		},
+		// SHA256 hex digest of the input
+		"SHA256": func(data string) string {
+			sum := sha256.Sum256([]byte(data))
+			return hex.EncodeToString(sum[:])
+		},
+		// SHA512 hex digest of the input
+		"SHA512": func(data string) string {
+			sum := sha512.Sum512([]byte(data))
+			return hex.EncodeToString(sum[:])
+		},
+		// Fast non-cryptographic XXHash64 hex digest of the input
+		"XXHash64": func(data string) string {
+			sum := xxhash.Sum64String(data)
+			return fmt.Sprintf("%016x", sum)
+		},
+		// HMAC-SHA256 hex digest of message, keyed by key
+		"HMACSHA256": func(key, message string) string {
+			mac := hmac.New(sha256.New, []byte(key))
+			mac.Write([]byte(message))
+			return hex.EncodeToString(mac.Sum(nil))
+		},
+		// Hex encode input data
+		"HexEncode": func(data string) string {
+			return hex.EncodeToString([]byte(data))
+		},
+		// Hex decode input data
+		"HexDecode": func(data string) (string, error) {
+			b, err := hex.DecodeString(data)
+			if err != nil {
+				return "", err
+			}
+			return string(b), nil
+		},
+		// Look up a row by index (cycling with %len) from a registered CSV data source
+		"CSVRow": func(name string, index int64) (map[string]string, error) {
+			ds, ok := td.dataSources[name]
+			if !ok {
+				return nil, dataSourceNotFoundErr(name)
+			}
+			row, err := ds.Row(index)
+			if err != nil {
+				return nil, err
+			}
+			r, ok := row.(map[string]string)
+			if !ok {
+				return nil, errors.New("data source " + name + " is not a CSV source")
+			}
+			return r, nil
+		},
+		// Look up an element by index (cycling with %len) from a registered JSON or JSONL data source
+		"JSONAt": func(name string, index int64) (interface{}, error) {
+			ds, ok := td.dataSources[name]
+			if !ok {
+				return nil, dataSourceNotFoundErr(name)
+			}
+			return ds.Row(index)
+		},
+		// Return a pseudo-random row from a registered data source
+		"RandomRow": func(name string) (interface{}, error) {
+			ds, ok := td.dataSources[name]
+			if !ok {
+				return nil, dataSourceNotFoundErr(name)
+			}
+			return ds.RandomRow()
+		},
+		// Return this worker's disjoint shard of a registered data
+		// source, so concurrent workers each draw from their own slice
+		// instead of all replaying the same rows
+		"Partition": func(name string) ([]interface{}, error) {
+			ds, ok := td.dataSources[name]
+			if !ok {
+				return nil, dataSourceNotFoundErr(name)
+			}
+			return ds.Partition(td.WorkerID, td.workerCount)
+		},
 	}).Parse(data))
 	var tpl bytes.Buffer
 	err := t.Execute(&tpl, td)
@@ -0,0 +1,168 @@
+package runner
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallTemplateData_UUIDFuncs(t *testing.T) {
+	td := &callTemplateData{RequestNumber: 1}
+
+	t.Run("UUIDv1", func(t *testing.T) {
+		tpl, err := td.execute(`{{UUIDv1}}`)
+		assert.NoError(t, err)
+		assert.Len(t, tpl.String(), 36)
+	})
+
+	t.Run("UUIDv7", func(t *testing.T) {
+		tpl, err := td.execute(`{{UUIDv7}}`)
+		assert.NoError(t, err)
+		assert.Len(t, tpl.String(), 36)
+	})
+
+	t.Run("UUIDv3 stable for same namespace and name", func(t *testing.T) {
+		tmpl := `{{UUIDv3 "6ba7b810-9dad-11d1-80b4-00c04fd430c8" "user-1"}}`
+		first, err := td.execute(tmpl)
+		assert.NoError(t, err)
+		second, err := td.execute(tmpl)
+		assert.NoError(t, err)
+		assert.Equal(t, first.String(), second.String())
+	})
+
+	t.Run("UUIDv5 stable for same namespace and name", func(t *testing.T) {
+		tmpl := `{{UUIDv5 "6ba7b810-9dad-11d1-80b4-00c04fd430c8" "user-1"}}`
+		first, err := td.execute(tmpl)
+		assert.NoError(t, err)
+		second, err := td.execute(tmpl)
+		assert.NoError(t, err)
+		assert.Equal(t, first.String(), second.String())
+	})
+
+	t.Run("UUIDv3 invalid namespace", func(t *testing.T) {
+		_, err := td.execute(`{{UUIDv3 "not-a-uuid" "user-1"}}`)
+		assert.Error(t, err)
+	})
+
+	t.Run("UUIDv5 invalid namespace", func(t *testing.T) {
+		_, err := td.execute(`{{UUIDv5 "not-a-uuid" "user-1"}}`)
+		assert.Error(t, err)
+	})
+}
+
+func TestCallTemplateData_HashFuncs(t *testing.T) {
+	td := &callTemplateData{RequestNumber: 1}
+
+	t.Run("MD5", func(t *testing.T) {
+		tpl, err := td.execute(`{{MD5 "hello"}}`)
+		assert.NoError(t, err)
+		assert.Equal(t, "5d41402abc4b2a76b9719d911017c592", tpl.String())
+	})
+
+	t.Run("SHA1", func(t *testing.T) {
+		tpl, err := td.execute(`{{SHA1 "hello"}}`)
+		assert.NoError(t, err)
+		assert.Equal(t, "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", tpl.String())
+	})
+
+	t.Run("SHA256", func(t *testing.T) {
+		tpl, err := td.execute(`{{SHA256 "hello"}}`)
+		assert.NoError(t, err)
+		assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", tpl.String())
+	})
+
+	t.Run("XXHash64 is zero-padded to a fixed width", func(t *testing.T) {
+		tpl, err := td.execute(`{{XXHash64 "a"}}`)
+		assert.NoError(t, err)
+		assert.Len(t, tpl.String(), 16)
+	})
+
+	t.Run("XXHash64 stable for identical input", func(t *testing.T) {
+		first, err := td.execute(`{{XXHash64 "hello"}}`)
+		assert.NoError(t, err)
+		second, err := td.execute(`{{XXHash64 "hello"}}`)
+		assert.NoError(t, err)
+		assert.Equal(t, first.String(), second.String())
+	})
+
+	t.Run("HMACSHA256", func(t *testing.T) {
+		tpl, err := td.execute(`{{HMACSHA256 "secret" "message"}}`)
+		assert.NoError(t, err)
+		assert.Len(t, tpl.String(), 64)
+	})
+
+	t.Run("HexEncode and HexDecode round trip", func(t *testing.T) {
+		encoded, err := td.execute(`{{HexEncode "hello"}}`)
+		assert.NoError(t, err)
+		assert.Equal(t, "68656c6c6f", encoded.String())
+
+		decoded, err := td.execute(`{{HexDecode "68656c6c6f"}}`)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", decoded.String())
+	})
+
+	t.Run("HexDecode invalid input", func(t *testing.T) {
+		_, err := td.execute(`{{HexDecode "zz"}}`)
+		assert.Error(t, err)
+	})
+}
+
+func TestWeightedChoice(t *testing.T) {
+	t.Run("always picks the only non-zero weight", func(t *testing.T) {
+		value, err := weightedChoice([]string{"a", "b", "c"}, []int{0, 1, 0})
+		assert.NoError(t, err)
+		assert.Equal(t, "b", value)
+	})
+
+	t.Run("mismatched lengths", func(t *testing.T) {
+		_, err := weightedChoice([]string{"a", "b"}, []int{1})
+		assert.Error(t, err)
+	})
+
+	t.Run("negative weight", func(t *testing.T) {
+		_, err := weightedChoice([]string{"a", "b"}, []int{1, -1})
+		assert.Error(t, err)
+	})
+
+	t.Run("all zero weights", func(t *testing.T) {
+		_, err := weightedChoice([]string{"a", "b"}, []int{0, 0})
+		assert.Error(t, err)
+	})
+
+	t.Run("empty values", func(t *testing.T) {
+		_, err := weightedChoice(nil, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestCallTemplateData_DistributionFuncs(t *testing.T) {
+	td := &callTemplateData{RequestNumber: 1}
+
+	t.Run("NormalInt returns an int", func(t *testing.T) {
+		tpl, err := td.execute(`{{NormalInt 100.0 10.0}}`)
+		assert.NoError(t, err)
+		_, err = strconv.Atoi(tpl.String())
+		assert.NoError(t, err)
+	})
+
+	t.Run("ExpInt returns an int", func(t *testing.T) {
+		tpl, err := td.execute(`{{ExpInt 5.0}}`)
+		assert.NoError(t, err)
+		_, err = strconv.Atoi(tpl.String())
+		assert.NoError(t, err)
+	})
+
+	t.Run("ZipfInt returns a uint in range", func(t *testing.T) {
+		tpl, err := td.execute(`{{ZipfInt 1.2 1.0 100}}`)
+		assert.NoError(t, err)
+		v, err := strconv.ParseUint(tpl.String(), 10, 64)
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, v, uint64(100))
+	})
+
+	t.Run("ZipfInt invalid s", func(t *testing.T) {
+		_, err := td.execute(`{{ZipfInt 0.5 1.0 100}}`)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,153 @@
+package runner
+
+import (
+	"context"
+	"time"
+)
+
+// Config holds the settings for a single run of Run, built up from
+// RunOption values. Call and Host are passed directly to Run rather than
+// through an option, mirroring how the ghz CLI always requires both.
+type Config struct {
+	protoFile   string
+	importPaths []string
+	protoset    string
+	reflection  bool
+
+	dataJSON     string
+	dataFile     string
+	metadataJSON string
+	metadataFile string
+
+	concurrency uint
+	rps         uint
+	total       uint
+	duration    time.Duration
+
+	insecure bool
+
+	ctx              context.Context
+	progressListener ProgressListener
+}
+
+// RunOption configures a Config consumed by Run. It's a distinct type
+// from the call-template-construction Option in data_source.go: that one
+// shapes a single worker's callTemplateData, this one shapes settings for
+// the whole run (target, concurrency, load shape, cancellation).
+type RunOption func(*Config)
+
+// WithProtoFile configures the method to call from a .proto source file,
+// resolving any imports it has against importPaths.
+func WithProtoFile(protoFile string, importPaths []string) RunOption {
+	return func(c *Config) {
+		c.protoFile = protoFile
+		c.importPaths = importPaths
+	}
+}
+
+// WithProtoset configures the method to call from a compiled
+// FileDescriptorSet, avoiding the need to parse .proto sources at all.
+func WithProtoset(protoset string) RunOption {
+	return func(c *Config) {
+		c.protoset = protoset
+	}
+}
+
+// WithReflectionService resolves the method to call using the target
+// server's reflection service instead of a local proto source.
+func WithReflectionService() RunOption {
+	return func(c *Config) {
+		c.reflection = true
+	}
+}
+
+// WithDataFromJSON sets the request payload template from a literal JSON
+// string.
+func WithDataFromJSON(data string) RunOption {
+	return func(c *Config) {
+		c.dataJSON = data
+	}
+}
+
+// WithDataFromFile sets the request payload template from a JSON file.
+func WithDataFromFile(path string) RunOption {
+	return func(c *Config) {
+		c.dataFile = path
+	}
+}
+
+// WithMetadataFromJSON sets the call metadata template from a literal
+// JSON string.
+func WithMetadataFromJSON(metadata string) RunOption {
+	return func(c *Config) {
+		c.metadataJSON = metadata
+	}
+}
+
+// WithMetadataFromFile sets the call metadata template from a JSON file.
+func WithMetadataFromFile(path string) RunOption {
+	return func(c *Config) {
+		c.metadataFile = path
+	}
+}
+
+// WithConcurrency sets the number of concurrent workers issuing calls.
+func WithConcurrency(n uint) RunOption {
+	return func(c *Config) {
+		c.concurrency = n
+	}
+}
+
+// WithRPS caps the aggregate request rate across all workers. Zero means
+// unbounded.
+func WithRPS(n uint) RunOption {
+	return func(c *Config) {
+		c.rps = n
+	}
+}
+
+// WithTotalRequests stops the run after n requests have completed.
+func WithTotalRequests(n uint) RunOption {
+	return func(c *Config) {
+		c.total = n
+	}
+}
+
+// WithRunDuration stops the run after d has elapsed. WithTotalRequests and
+// WithRunDuration may be combined; the run stops at whichever is hit
+// first.
+func WithRunDuration(d time.Duration) RunOption {
+	return func(c *Config) {
+		c.duration = d
+	}
+}
+
+// WithInsecure selects a plaintext connection to the target instead of
+// TLS.
+func WithInsecure(insecure bool) RunOption {
+	return func(c *Config) {
+		c.insecure = insecure
+	}
+}
+
+// WithContext binds ctx to the run: cancelling it stops in-flight workers
+// as soon as their current call returns, rather than only severing the
+// caller's view of the run (e.g. a controller's StartRun stream).
+func WithContext(ctx context.Context) RunOption {
+	return func(c *Config) {
+		c.ctx = ctx
+	}
+}
+
+// ProgressListener receives a Snapshot of run progress at a regular
+// interval while Run is in flight. It's called from Run's internal
+// reporting goroutine and must return quickly.
+type ProgressListener func(Snapshot)
+
+// WithProgressListener registers fn to receive periodic Snapshots while
+// the run is in flight.
+func WithProgressListener(fn ProgressListener) RunOption {
+	return func(c *Config) {
+		c.progressListener = fn
+	}
+}
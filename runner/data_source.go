@@ -0,0 +1,138 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+)
+
+// DataSource is a named, pre-loaded collection of structured records that
+// template functions (CSVRow, JSONAt, RandomRow) can draw from at call
+// time. Implementations are loaded once when the source is registered and
+// must be safe for concurrent use by multiple workers.
+type DataSource interface {
+	// Row returns the record at index, cycling via modulo against the
+	// dataset length so callers can index straight off RequestNumber.
+	Row(index int64) (interface{}, error)
+	// Partition returns the disjoint slice of records assigned to
+	// workerID out of workerCount total workers, so that concurrent
+	// workers draw from non-overlapping parts of the dataset rather than
+	// racing over the same rows.
+	Partition(workerID string, workerCount int) ([]interface{}, error)
+	// RandomRow returns a pseudo-randomly selected record.
+	RandomRow() (interface{}, error)
+	// Len reports the number of records held by the source.
+	Len() int
+	// Close releases any resources (e.g. open files) held by the source.
+	// Static sources that load fully into memory at construction need it
+	// only to satisfy the interface; streaming sources rely on it to
+	// avoid leaking file descriptors on long-running processes.
+	Close() error
+}
+
+// options holds settings applied once at construction time, such as the
+// set of registered data sources.
+type options struct {
+	dataSources map[string]DataSource
+	workerCount int
+}
+
+// Option configures call template data construction.
+type Option func(*options)
+
+// WithDataSource registers a named DataSource that the CSVRow, JSONAt, and
+// RandomRow template functions can draw records from. It applies to call
+// template construction (newCallTemplateData); Run takes RunOption values
+// only and has no option that registers a data source.
+func WithDataSource(name string, ds DataSource) Option {
+	return func(o *options) {
+		if o.dataSources == nil {
+			o.dataSources = make(map[string]DataSource)
+		}
+		o.dataSources[name] = ds
+	}
+}
+
+// WithWorkerCount tells call template construction how many concurrent
+// workers are running, so the Partition template function can divide a
+// data source into that many disjoint shards.
+func WithWorkerCount(n int) Option {
+	return func(o *options) {
+		o.workerCount = n
+	}
+}
+
+// staticDataSource is a DataSource backed by a fully materialized, in-memory
+// slice of records. CSV and JSON array sources are both static once loaded.
+type staticDataSource struct {
+	rows []interface{}
+}
+
+func (s *staticDataSource) Row(index int64) (interface{}, error) {
+	if len(s.rows) == 0 {
+		return nil, errors.New("data source is empty")
+	}
+	i := index % int64(len(s.rows))
+	if i < 0 {
+		i += int64(len(s.rows))
+	}
+	return s.rows[i], nil
+}
+
+func (s *staticDataSource) Partition(workerID string, workerCount int) ([]interface{}, error) {
+	if len(s.rows) == 0 {
+		return nil, errors.New("data source is empty")
+	}
+	if workerCount <= 0 {
+		return nil, errors.New("workerCount must be > 0")
+	}
+	ordinal := workerOrdinal(workerID)
+	shard := ordinal % workerCount
+	var partition []interface{}
+	for i := shard; i < len(s.rows); i += workerCount {
+		partition = append(partition, s.rows[i])
+	}
+	return partition, nil
+}
+
+func (s *staticDataSource) RandomRow() (interface{}, error) {
+	if len(s.rows) == 0 {
+		return nil, errors.New("data source is empty")
+	}
+	return s.rows[rand.Intn(len(s.rows))], nil
+}
+
+func (s *staticDataSource) Len() int {
+	return len(s.rows)
+}
+
+// Close is a no-op: staticDataSource loads fully into memory at
+// construction and holds no resources afterward.
+func (s *staticDataSource) Close() error {
+	return nil
+}
+
+// workerOrdinal derives a stable, non-negative ordinal from a worker ID so
+// partitioning assigns a consistent shard to the same worker across calls.
+// Worker IDs are formatted as a prefix followed by a numeric suffix (e.g.
+// "g0", "g1"); fall back to hashing the whole ID when that isn't the case.
+func workerOrdinal(workerID string) int {
+	for i := 0; i < len(workerID); i++ {
+		if workerID[i] >= '0' && workerID[i] <= '9' {
+			if n, err := strconv.Atoi(workerID[i:]); err == nil {
+				return n
+			}
+			break
+		}
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(workerID))
+	return int(h.Sum32())
+}
+
+func dataSourceNotFoundErr(name string) error {
+	return fmt.Errorf("data source %q is not registered", name)
+}
@@ -0,0 +1,39 @@
+package runner
+
+import (
+	"encoding/csv"
+	"os"
+)
+
+// NewCSVDataSource loads a CSV file into memory and returns a DataSource
+// whose rows are maps keyed by the header in the file's first line.
+func NewCSVDataSource(path string) (DataSource, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return &staticDataSource{}, nil
+	}
+
+	header := records[0]
+	rows := make([]interface{}, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := make(map[string]string, len(header))
+		for i, col := range header {
+			if i < len(record) {
+				row[col] = record[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return &staticDataSource{rows: rows}, nil
+}
@@ -0,0 +1,23 @@
+package runner
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// NewJSONDataSource loads a JSON array from a file into memory and returns
+// a DataSource whose rows are the array's decoded elements.
+func NewJSONDataSource(path string) (DataSource, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []interface{}
+	if err := json.NewDecoder(f).Decode(&records); err != nil {
+		return nil, err
+	}
+
+	return &staticDataSource{rows: records}, nil
+}
@@ -0,0 +1,123 @@
+package runner
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"os"
+	"sync"
+)
+
+// jsonlDataSource is a DataSource backed by a JSON Lines file. Only the
+// byte offset of each line is kept in memory; individual rows are decoded
+// on demand so the source stays memory-bounded regardless of file size.
+type jsonlDataSource struct {
+	mu      sync.Mutex
+	f       *os.File
+	offsets []int64
+}
+
+// NewJSONLDataSource indexes the byte offset of each line in a JSON Lines
+// file without holding the decoded content in memory, then returns a
+// DataSource that decodes rows lazily as they're requested.
+func NewJSONLDataSource(path string) (DataSource, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var offsets []int64
+	var offset int64
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) > 0 {
+			offsets = append(offsets, offset)
+		}
+		offset += int64(len(line)) + 1
+	}
+	if err := scanner.Err(); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &jsonlDataSource{f: f, offsets: offsets}, nil
+}
+
+func (s *jsonlDataSource) readAt(index int) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.f.Seek(s.offsets[index], 0); err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(s.f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("short read from jsonl data source")
+	}
+
+	var row interface{}
+	if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func (s *jsonlDataSource) Row(index int64) (interface{}, error) {
+	if len(s.offsets) == 0 {
+		return nil, errors.New("data source is empty")
+	}
+	i := int(index % int64(len(s.offsets)))
+	if i < 0 {
+		i += len(s.offsets)
+	}
+	return s.readAt(i)
+}
+
+func (s *jsonlDataSource) Partition(workerID string, workerCount int) ([]interface{}, error) {
+	if len(s.offsets) == 0 {
+		return nil, errors.New("data source is empty")
+	}
+	if workerCount <= 0 {
+		return nil, errors.New("workerCount must be > 0")
+	}
+	ordinal := workerOrdinal(workerID)
+	shard := ordinal % workerCount
+
+	var partition []interface{}
+	for i := shard; i < len(s.offsets); i += workerCount {
+		row, err := s.readAt(i)
+		if err != nil {
+			return nil, err
+		}
+		partition = append(partition, row)
+	}
+	return partition, nil
+}
+
+func (s *jsonlDataSource) RandomRow() (interface{}, error) {
+	if len(s.offsets) == 0 {
+		return nil, errors.New("data source is empty")
+	}
+	return s.readAt(rand.Intn(len(s.offsets)))
+}
+
+func (s *jsonlDataSource) Len() int {
+	return len(s.offsets)
+}
+
+// Close releases the underlying file handle. Callers running a
+// long-lived process (e.g. ghz-serve) should close a jsonlDataSource once
+// it's no longer needed, since NewJSONLDataSource keeps the file open for
+// the lifetime of the source to support lazy, memory-bounded reads.
+func (s *jsonlDataSource) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
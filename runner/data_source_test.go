@@ -0,0 +1,131 @@
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(p, []byte(content), 0600))
+	return p
+}
+
+func TestCSVDataSource(t *testing.T) {
+	path := writeTempFile(t, "users.csv", "id,name\n1,alice\n2,bob\n")
+
+	ds, err := NewCSVDataSource(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, ds.Len())
+
+	row, err := ds.Row(0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"id": "1", "name": "alice"}, row)
+
+	// cycles via modulo
+	row, err = ds.Row(2)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"id": "1", "name": "alice"}, row)
+
+	random, err := ds.RandomRow()
+	require.NoError(t, err)
+	assert.NotNil(t, random)
+}
+
+func TestJSONDataSource(t *testing.T) {
+	path := writeTempFile(t, "products.json", `[{"sku":"a"},{"sku":"b"},{"sku":"c"}]`)
+
+	ds, err := NewJSONDataSource(path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, ds.Len())
+
+	row, err := ds.Row(1)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"sku": "b"}, row)
+}
+
+func TestJSONLDataSource(t *testing.T) {
+	path := writeTempFile(t, "events.jsonl", "{\"n\":1}\n{\"n\":2}\n{\"n\":3}\n")
+
+	ds, err := NewJSONLDataSource(path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, ds.Len())
+
+	row, err := ds.Row(0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"n": float64(1)}, row)
+}
+
+func TestJSONLDataSourceClose(t *testing.T) {
+	path := writeTempFile(t, "events.jsonl", "{\"n\":1}\n")
+
+	ds, err := NewJSONLDataSource(path)
+	require.NoError(t, err)
+	require.NoError(t, ds.Close())
+
+	_, err = ds.Row(0)
+	assert.Error(t, err)
+}
+
+func TestDataSourcePartitionIsDisjoint(t *testing.T) {
+	path := writeTempFile(t, "rows.json", `[0,1,2,3,4,5]`)
+	ds, err := NewJSONDataSource(path)
+	require.NoError(t, err)
+
+	const workerCount = 2
+	p0, err := ds.Partition("g0", workerCount)
+	require.NoError(t, err)
+	p1, err := ds.Partition("g1", workerCount)
+	require.NoError(t, err)
+
+	// every worker's shard should be non-empty and, together, cover the
+	// whole dataset exactly once
+	assert.Len(t, p0, 3)
+	assert.Len(t, p1, 3)
+
+	seen := make(map[interface{}]bool)
+	for _, v := range append(p0, p1...) {
+		assert.False(t, seen[v], "value %v seen in more than one partition", v)
+		seen[v] = true
+	}
+	assert.Len(t, seen, ds.Len())
+}
+
+func TestDataSourcePartitionRequiresWorkerCount(t *testing.T) {
+	path := writeTempFile(t, "rows.json", `[0,1,2]`)
+	ds, err := NewJSONDataSource(path)
+	require.NoError(t, err)
+
+	_, err = ds.Partition("g0", 0)
+	assert.Error(t, err)
+}
+
+func TestCallTemplateData_DataSourceFuncs(t *testing.T) {
+	path := writeTempFile(t, "users.csv", "id,name\n1,alice\n2,bob\n")
+	ds, err := NewCSVDataSource(path)
+	require.NoError(t, err)
+
+	o := &options{}
+	WithDataSource("users", ds)(o)
+	WithWorkerCount(2)(o)
+	td := &callTemplateData{WorkerID: "g0", dataSources: o.dataSources, workerCount: o.workerCount}
+
+	tpl, err := td.execute(`{{(CSVRow "users" 0).name}}`)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", tpl.String())
+
+	_, err = td.execute(`{{CSVRow "missing" 0}}`)
+	assert.Error(t, err)
+
+	tpl, err = td.execute(`{{len (Partition "users")}}`)
+	require.NoError(t, err)
+	assert.Equal(t, "1", tpl.String())
+
+	_, err = td.execute(`{{Partition "missing"}}`)
+	assert.Error(t, err)
+}
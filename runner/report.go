@@ -0,0 +1,144 @@
+package runner
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Report is the terminal summary of a completed run.
+type Report struct {
+	Count   uint64
+	Total   time.Duration
+	Average time.Duration
+	Rps     float64
+
+	// StatusCodes counts completed calls by their gRPC status code, e.g.
+	// "OK", "DeadlineExceeded". Calls that never reached the server (dial
+	// failures, etc.) are not represented here.
+	StatusCodes map[string]uint64
+}
+
+// Snapshot is a point-in-time view of an in-progress run, pushed to a
+// ProgressListener roughly every snapshotInterval.
+type Snapshot struct {
+	Completed    uint64
+	Errors       uint64
+	CurrentRPS   float64
+	P50LatencyMs float64
+	P99LatencyMs float64
+}
+
+// snapshotInterval is how often the reporting goroutine in Run recomputes
+// a Snapshot and hands it to the configured ProgressListener.
+const snapshotInterval = 200 * time.Millisecond
+
+// liveStats accumulates the counts and latencies a running call loop
+// records, and derives Snapshots and the terminal Report from them. All
+// methods are safe for concurrent use by multiple worker goroutines.
+type liveStats struct {
+	mu          sync.Mutex
+	start       time.Time
+	completed   uint64
+	errors      uint64
+	latencies   []time.Duration
+	statusCodes map[string]uint64
+}
+
+func newLiveStats() *liveStats {
+	return &liveStats{start: time.Now(), statusCodes: make(map[string]uint64)}
+}
+
+// record stores the outcome of one completed call. statusCode is the
+// gRPC status string (e.g. "OK"); err is non-nil when the call itself
+// failed to go out (e.g. a dial or marshal error, not a status code).
+func (s *liveStats) record(d time.Duration, statusCode string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.completed++
+	if err != nil {
+		s.errors++
+	} else {
+		s.statusCodes[statusCode]++
+	}
+	s.latencies = append(s.latencies, d)
+}
+
+func (s *liveStats) count() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.completed
+}
+
+// snapshot computes a Snapshot from the stats recorded so far.
+func (s *liveStats) snapshot() Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elapsed := time.Since(s.start).Seconds()
+	var rps float64
+	if elapsed > 0 {
+		rps = float64(s.completed) / elapsed
+	}
+
+	p50, p99 := percentiles(s.latencies)
+	return Snapshot{
+		Completed:    s.completed,
+		Errors:       s.errors,
+		CurrentRPS:   rps,
+		P50LatencyMs: p50,
+		P99LatencyMs: p99,
+	}
+}
+
+// report computes the terminal Report from all stats recorded over the
+// run's lifetime.
+func (s *liveStats) report() *Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	total := time.Since(s.start)
+	var avg time.Duration
+	if len(s.latencies) > 0 {
+		var sum time.Duration
+		for _, d := range s.latencies {
+			sum += d
+		}
+		avg = sum / time.Duration(len(s.latencies))
+	}
+	var rps float64
+	if total.Seconds() > 0 {
+		rps = float64(s.completed) / total.Seconds()
+	}
+
+	codes := make(map[string]uint64, len(s.statusCodes))
+	for k, v := range s.statusCodes {
+		codes[k] = v
+	}
+
+	return &Report{
+		Count:       s.completed,
+		Total:       total,
+		Average:     avg,
+		Rps:         rps,
+		StatusCodes: codes,
+	}
+}
+
+// percentiles returns the p50 and p99 latency, in milliseconds, of the
+// given (unsorted) samples. It sorts a copy, leaving latencies untouched.
+func percentiles(latencies []time.Duration) (p50, p99 float64) {
+	if len(latencies) == 0 {
+		return 0, 0
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	at := func(pct float64) float64 {
+		i := int(pct * float64(len(sorted)-1))
+		return float64(sorted[i]) / float64(time.Millisecond)
+	}
+	return at(0.50), at(0.99)
+}
@@ -0,0 +1,38 @@
+package runner
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveStatsReport(t *testing.T) {
+	s := newLiveStats()
+	s.record(10*time.Millisecond, "OK", nil)
+	s.record(20*time.Millisecond, "OK", nil)
+	s.record(0, "", errors.New("dial failed"))
+
+	report := s.report()
+	assert.Equal(t, uint64(3), report.Count)
+	assert.Equal(t, map[string]uint64{"OK": 2}, report.StatusCodes)
+
+	snap := s.snapshot()
+	assert.Equal(t, uint64(3), snap.Completed)
+	assert.Equal(t, uint64(1), snap.Errors)
+}
+
+func TestPercentiles(t *testing.T) {
+	p50, p99 := percentiles(nil)
+	assert.Zero(t, p50)
+	assert.Zero(t, p99)
+
+	latencies := make([]time.Duration, 0, 100)
+	for i := 1; i <= 100; i++ {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	p50, p99 = percentiles(latencies)
+	assert.Equal(t, 50.0, p50)
+	assert.Equal(t, 99.0, p99)
+}
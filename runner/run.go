@@ -0,0 +1,310 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/jhump/protoreflect/dynamic/grpcdynamic"
+	"github.com/jhump/protoreflect/grpcreflect"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// defaultConcurrency is the worker count used when no WithConcurrency
+// option is given.
+const defaultConcurrency = 1
+
+// Run drives a load test against the unary call identified by its
+// fully-qualified method name (e.g. "helloworld.Greeter.SayHello") at
+// host, configured by opts, and returns the terminal Report once the run
+// stops (by hitting WithTotalRequests, WithRunDuration, or having its
+// WithContext cancelled).
+func Run(call, host string, opts ...RunOption) (*Report, error) {
+	cfg := &Config{concurrency: defaultConcurrency}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	ctx := cfg.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if cfg.duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.duration)
+		defer cancel()
+	}
+
+	mtd, cc, err := resolveMethod(ctx, call, host, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("resolving method %q: %w", call, err)
+	}
+	defer cc.Close()
+	if mtd.IsClientStreaming() || mtd.IsServerStreaming() {
+		return nil, fmt.Errorf("call %q is streaming, which Run does not yet support", call)
+	}
+
+	dataTemplate, err := loadTemplateInput(cfg.dataJSON, cfg.dataFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading request data: %w", err)
+	}
+	metadataTemplate, err := loadTemplateInput(cfg.metadataJSON, cfg.metadataFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading request metadata: %w", err)
+	}
+
+	stats := newLiveStats()
+	reportProgress(ctx, stats, cfg.progressListener)
+
+	var limiter <-chan time.Time
+	if cfg.rps > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(cfg.rps))
+		defer ticker.Stop()
+		limiter = ticker.C
+	}
+
+	stub := grpcdynamic.NewStub(cc)
+	var reqNum int64
+	var wg sync.WaitGroup
+	for w := 0; w < int(cfg.concurrency); w++ {
+		workerID := fmt.Sprintf("g%d", w)
+		wg.Add(1)
+		go func(workerID string) {
+			defer wg.Done()
+			for {
+				if cfg.total > 0 && stats.count() >= uint64(cfg.total) {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+				if limiter != nil {
+					select {
+					case <-limiter:
+					case <-ctx.Done():
+						return
+					}
+				}
+
+				n := atomic.AddInt64(&reqNum, 1) - 1
+				callOneRequest(ctx, stub, mtd, workerID, n, dataTemplate, metadataTemplate, cfg, stats)
+			}
+		}(workerID)
+	}
+	wg.Wait()
+
+	return stats.report(), nil
+}
+
+// callOneRequest renders the request/metadata templates for request n,
+// invokes the RPC, and records the outcome in stats.
+func callOneRequest(ctx context.Context, stub grpcdynamic.Stub, mtd *desc.MethodDescriptor, workerID string, n int64, dataTemplate, metadataTemplate string, cfg *Config, stats *liveStats) {
+	td := newCallTemplateData(mtd, workerID, n, WithWorkerCount(int(cfg.concurrency)))
+
+	req := dynamic.NewMessage(mtd.GetInputType())
+	if dataTemplate != "" {
+		payload, err := td.executeData(dataTemplate)
+		if err != nil {
+			stats.record(0, "", err)
+			return
+		}
+		if err := req.UnmarshalJSON(payload); err != nil {
+			stats.record(0, "", err)
+			return
+		}
+	}
+
+	callCtx := ctx
+	if metadataTemplate != "" {
+		md, err := td.executeMetadata(metadataTemplate)
+		if err != nil {
+			stats.record(0, "", err)
+			return
+		}
+		callCtx = metadataToContext(ctx, *md)
+	}
+
+	start := time.Now()
+	_, err := stub.InvokeRpc(callCtx, mtd, req)
+	elapsed := time.Since(start)
+
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			stats.record(elapsed, "", err)
+			return
+		}
+		stats.record(elapsed, st.Code().String(), nil)
+		return
+	}
+	stats.record(elapsed, codes.OK.String(), nil)
+}
+
+// reportProgress starts a goroutine that pushes a Snapshot of stats to fn
+// every snapshotInterval until ctx is done. It's a no-op if fn is nil.
+func reportProgress(ctx context.Context, stats *liveStats, fn ProgressListener) {
+	if fn == nil {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(snapshotInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				fn(stats.snapshot())
+			}
+		}
+	}()
+}
+
+// resolveMethod finds the MethodDescriptor for call using whichever proto
+// source cfg was configured with: a local .proto file, a compiled
+// FileDescriptorSet (protoset), or the target server's reflection
+// service. It also dials host, since the reflection path needs a
+// connection anyway; callers reuse the returned ClientConn for the run
+// itself instead of dialing a second time.
+func resolveMethod(ctx context.Context, call, host string, cfg *Config) (*desc.MethodDescriptor, *grpc.ClientConn, error) {
+	cc, err := dialTarget(ctx, host, cfg.insecure)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var files []*desc.FileDescriptor
+	switch {
+	case cfg.protoFile != "":
+		parser := protoparse.Parser{ImportPaths: cfg.importPaths}
+		fds, err := parser.ParseFiles(cfg.protoFile)
+		if err != nil {
+			cc.Close()
+			return nil, nil, err
+		}
+		files = fds
+
+	case cfg.protoset != "":
+		b, err := os.ReadFile(cfg.protoset)
+		if err != nil {
+			cc.Close()
+			return nil, nil, err
+		}
+		var set descriptorpb.FileDescriptorSet
+		if err := proto.Unmarshal(b, &set); err != nil {
+			cc.Close()
+			return nil, nil, err
+		}
+		fds, err := desc.CreateFileDescriptorsFromSet(&set)
+		if err != nil {
+			cc.Close()
+			return nil, nil, err
+		}
+		for _, fd := range fds {
+			files = append(files, fd)
+		}
+
+	case cfg.reflection:
+		client := grpcreflect.NewClientAuto(ctx, cc)
+		defer client.Reset()
+
+		i := strings.LastIndex(call, ".")
+		if i < 0 {
+			cc.Close()
+			return nil, nil, fmt.Errorf("call %q must be fully qualified as package.Service.Method", call)
+		}
+		svc, err := client.ResolveService(call[:i])
+		if err != nil {
+			cc.Close()
+			return nil, nil, err
+		}
+		mtd := svc.FindMethodByName(call[i+1:])
+		if mtd == nil {
+			cc.Close()
+			return nil, nil, fmt.Errorf("service %q has no method %q", call[:i], call[i+1:])
+		}
+		return mtd, cc, nil
+
+	default:
+		cc.Close()
+		return nil, nil, errors.New("no proto source configured: use WithProtoFile, WithProtoset, or WithReflectionService")
+	}
+
+	mtd, err := findMethod(files, call)
+	if err != nil {
+		cc.Close()
+		return nil, nil, err
+	}
+	return mtd, cc, nil
+}
+
+// findMethod searches the services defined across files for a method
+// matching call's fully-qualified name.
+func findMethod(files []*desc.FileDescriptor, call string) (*desc.MethodDescriptor, error) {
+	for _, fd := range files {
+		for _, svc := range fd.GetServices() {
+			for _, mtd := range svc.GetMethods() {
+				if mtd.GetFullyQualifiedName() == call {
+					return mtd, nil
+				}
+			}
+		}
+	}
+	return nil, fmt.Errorf("method %q not found", call)
+}
+
+// dialTarget opens a gRPC connection to host, using plaintext transport
+// when insecure is set and the system's default TLS trust store
+// otherwise.
+func dialTarget(ctx context.Context, host string, insecureConn bool) (*grpc.ClientConn, error) {
+	creds := credentials.NewTLS(nil)
+	if insecureConn {
+		creds = insecure.NewCredentials()
+	}
+	return grpc.NewClient(host, grpc.WithTransportCredentials(creds))
+}
+
+// metadataToContext attaches md as outgoing gRPC metadata on ctx.
+func metadataToContext(ctx context.Context, md map[string]string) context.Context {
+	if len(md) == 0 {
+		return ctx
+	}
+	pairs := make([]string, 0, len(md)*2)
+	for k, v := range md {
+		pairs = append(pairs, k, v)
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+// loadTemplateInput returns the template source from whichever of the
+// literal or file-based input was configured; literal takes precedence.
+func loadTemplateInput(literal, path string) (string, error) {
+	if literal != "" {
+		return literal, nil
+	}
+	if path == "" {
+		return "", nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}